@@ -0,0 +1,23 @@
+package main
+
+// Color represents a basic RGB primary color.
+type Color int
+
+// The primary colors, in the order they were added to the palette.
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+// Status represents the lifecycle of a job.
+type Status int
+
+const (
+	// StatusPending is the initial state before a job starts.
+	StatusPending Status = iota
+	// StatusRunning marks a job that is currently executing.
+	StatusRunning
+	// StatusDone marks a job that finished successfully.
+	StatusDone
+)